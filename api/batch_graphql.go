@@ -0,0 +1,194 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// ErrorClass categorizes why a single aliased query within a BatchGraphQL
+// call failed, so callers can decide which failures are safe to ignore.
+type ErrorClass string
+
+const (
+	ErrorNotFound    ErrorClass = "NOT_FOUND"
+	ErrorForbidden   ErrorClass = "FORBIDDEN"
+	ErrorRateLimited ErrorClass = "RATE_LIMITED"
+	ErrorTransient   ErrorClass = "TRANSIENT"
+	ErrorUnknown     ErrorClass = "UNKNOWN"
+)
+
+// ClassifyError maps a GraphQL error's type string to an ErrorClass. It's
+// the default classification policy used by BatchGraphQL; callers that need
+// different tolerances can override it via BatchGraphQLOptions.Classify.
+func ClassifyError(graphqlErrorType string) ErrorClass {
+	switch graphqlErrorType {
+	case "NOT_FOUND":
+		return ErrorNotFound
+	case "FORBIDDEN":
+		return ErrorForbidden
+	case "RATE_LIMITED":
+		return ErrorRateLimited
+	case "":
+		return ErrorUnknown
+	default:
+		return ErrorTransient
+	}
+}
+
+// Result is the outcome of decoding a single aliased query within a
+// BatchGraphQL call.
+type Result struct {
+	Alias string
+	Value *json.RawMessage
+	Err   error
+}
+
+// Decode unmarshals a successful Result's raw JSON into target. It's a
+// no-op returning nil when the Result carries an Err instead of a Value.
+func (r Result) Decode(target interface{}) error {
+	if r.Err != nil || r.Value == nil {
+		return nil
+	}
+	return json.NewDecoder(bytes.NewReader(*r.Value)).Decode(target)
+}
+
+// BatchGraphQLOptions configures BatchGraphQL's tolerance and retry policy.
+type BatchGraphQLOptions struct {
+	// Tolerate reports whether an error class should be swallowed (each
+	// affected alias's Result carries Err instead) rather than aborting the
+	// whole batch outright. Defaults to tolerating only ErrorNotFound, which
+	// matches RepoNetwork's original behavior.
+	Tolerate func(class ErrorClass) bool
+	// Classify maps a raw GraphQL error type to an ErrorClass. Defaults to ClassifyError.
+	Classify func(graphqlErrorType string) ErrorClass
+	// Retry is consulted for each distinct untolerated error class in a
+	// response; the whole batch query is re-issued only when it returns true
+	// for every one of them, at most maxBatchRetries times. Useful for
+	// ErrorRateLimited/ErrorTransient classes that are worth retrying.
+	Retry func(class ErrorClass) bool
+}
+
+func (o BatchGraphQLOptions) classify(t string) ErrorClass {
+	if o.Classify != nil {
+		return o.Classify(t)
+	}
+	return ClassifyError(t)
+}
+
+func (o BatchGraphQLOptions) tolerate(c ErrorClass) bool {
+	if o.Tolerate != nil {
+		return o.Tolerate(c)
+	}
+	return c == ErrorNotFound
+}
+
+// maxBatchRetries bounds how many times BatchGraphQL will re-issue a batch
+// query in response to opts.Retry, so a persistently retryable error class
+// (e.g. a rate limit that never clears) can't loop forever.
+const maxBatchRetries = 3
+
+// BatchGraphQL executes a GraphQL query built from aliased fragments (e.g.
+// `repo_000: repository(...) { ... }`) and returns one Result per alias, so
+// callers like RepoNetwork can tell which items failed and why rather than
+// aborting the whole batch on the first error.
+func BatchGraphQL(client *Client, query string, variables map[string]interface{}, opts BatchGraphQLOptions) (map[string]Result, error) {
+	for attempt := 0; ; attempt++ {
+		raw := make(map[string]*json.RawMessage)
+		err := client.GraphQL(query, variables, &raw)
+
+		graphqlErr, isGraphQLErr := err.(*GraphQLErrorResponse)
+		if !isGraphQLErr {
+			if err != nil {
+				return nil, err
+			}
+			return toResults(raw), nil
+		}
+
+		classes := map[ErrorClass]bool{}
+		allTolerated := true
+		for _, ge := range graphqlErr.Errors {
+			class := opts.classify(ge.Type)
+			classes[class] = true
+			if !opts.tolerate(class) {
+				allTolerated = false
+			}
+		}
+
+		if !allTolerated {
+			// Only retry when every untolerated class is itself retryable;
+			// a single non-retryable class (e.g. FORBIDDEN) alongside a
+			// retryable one (e.g. RATE_LIMITED) must still fail the batch.
+			canRetry := attempt < maxBatchRetries && opts.Retry != nil
+			if canRetry {
+				for class := range classes {
+					if opts.tolerate(class) {
+						continue
+					}
+					if !opts.Retry(class) {
+						canRetry = false
+						break
+					}
+				}
+			}
+			if !canRetry {
+				return nil, err
+			}
+			continue
+		}
+
+		return toResultsWithErr(raw, errorsByAlias(graphqlErr.Errors, opts.classify)), nil
+	}
+}
+
+func toResults(raw map[string]*json.RawMessage) map[string]Result {
+	results := make(map[string]Result, len(raw))
+	for alias, value := range raw {
+		results[alias] = Result{Alias: alias, Value: value}
+	}
+	return results
+}
+
+// toResultsWithErr builds the Result map for a batch whose top-level errors
+// were tolerated: aliases with a non-null value succeeded, aliases with a
+// null value get the specific error attributed to them via errorsByAlias
+// (falling back to a generic message if the server didn't report a path for
+// that alias).
+func toResultsWithErr(raw map[string]*json.RawMessage, perAlias map[string]error) map[string]Result {
+	results := make(map[string]Result, len(raw))
+	for alias, value := range raw {
+		if value == nil {
+			err, ok := perAlias[alias]
+			if !ok {
+				err = fmt.Errorf("alias %q failed for an unspecified reason", alias)
+			}
+			results[alias] = Result{Alias: alias, Err: err}
+			continue
+		}
+		results[alias] = Result{Alias: alias, Value: value}
+	}
+	return results
+}
+
+// errorsByAlias maps each GraphQL error back to the alias (the first path
+// segment) it was reported against, so a batch that tolerates more than one
+// error class still lets the caller tell, per alias, which reason applied.
+func errorsByAlias(errs []GraphQLError, classify func(string) ErrorClass) map[string]error {
+	perAlias := make(map[string]error, len(errs))
+	for _, ge := range errs {
+		alias, ok := aliasFromPath(ge.Path)
+		if !ok {
+			continue
+		}
+		perAlias[alias] = fmt.Errorf("%s: %s", classify(ge.Type), ge.Message)
+	}
+	return perAlias
+}
+
+func aliasFromPath(path []interface{}) (string, bool) {
+	if len(path) == 0 {
+		return "", false
+	}
+	alias, ok := path[0].(string)
+	return alias, ok
+}
@@ -0,0 +1,60 @@
+package api
+
+import "testing"
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		errType string
+		want    ErrorClass
+	}{
+		{"NOT_FOUND", ErrorNotFound},
+		{"FORBIDDEN", ErrorForbidden},
+		{"RATE_LIMITED", ErrorRateLimited},
+		{"", ErrorUnknown},
+		{"SOME_OTHER_ERROR", ErrorTransient},
+	}
+	for _, tt := range tests {
+		if got := ClassifyError(tt.errType); got != tt.want {
+			t.Errorf("ClassifyError(%q) = %q, want %q", tt.errType, got, tt.want)
+		}
+	}
+}
+
+func TestBatchGraphQLOptions_tolerate(t *testing.T) {
+	defaultOpts := BatchGraphQLOptions{}
+	if !defaultOpts.tolerate(ErrorNotFound) {
+		t.Error("default options should tolerate ErrorNotFound")
+	}
+	if defaultOpts.tolerate(ErrorForbidden) {
+		t.Error("default options should not tolerate ErrorForbidden")
+	}
+
+	custom := BatchGraphQLOptions{
+		Tolerate: func(class ErrorClass) bool {
+			return class == ErrorNotFound || class == ErrorForbidden
+		},
+	}
+	if !custom.tolerate(ErrorForbidden) {
+		t.Error("custom Tolerate policy should be used instead of the default")
+	}
+}
+
+func TestErrorsByAlias(t *testing.T) {
+	errs := []GraphQLError{
+		{Type: "NOT_FOUND", Message: "repo_000 not found", Path: []interface{}{"repo_000"}},
+		{Type: "FORBIDDEN", Message: "repo_001 forbidden", Path: []interface{}{"repo_001"}},
+		{Type: "NOT_FOUND", Message: "no path", Path: nil},
+	}
+
+	perAlias := errorsByAlias(errs, ClassifyError)
+
+	if len(perAlias) != 2 {
+		t.Fatalf("expected 2 attributed errors, got %d", len(perAlias))
+	}
+	if perAlias["repo_000"] == nil || perAlias["repo_001"] == nil {
+		t.Fatalf("expected errors for repo_000 and repo_001, got %v", perAlias)
+	}
+	if perAlias["repo_000"].Error() == perAlias["repo_001"].Error() {
+		t.Error("distinct aliases with distinct error classes must not collapse to the same message")
+	}
+}
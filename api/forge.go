@@ -0,0 +1,77 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// ForgeType identifies which git forge a repository is hosted on.
+type ForgeType string
+
+const (
+	// ForgeUnknown is returned by DetectForge when a host can't be matched
+	// to a known forge. It forces callers through NewForgeClient's explicit
+	// error path instead of silently guessing GitHub.
+	ForgeUnknown   ForgeType = ""
+	ForgeGitHub    ForgeType = "github"
+	ForgeGitLab    ForgeType = "gitlab"
+	ForgeGitea     ForgeType = "gitea"
+	ForgeBitbucket ForgeType = "bitbucket"
+)
+
+// ForgeClient exposes the repository operations the CLI needs against a
+// particular forge. Each implementation translates these high-level
+// operations into whatever REST or GraphQL calls its forge supports, so
+// that callers like `gh repo` commands don't need to know which forge a
+// repository lives on.
+type ForgeClient interface {
+	// LookupRepo fetches metadata about a single repository.
+	LookupRepo(repo ghrepo.Interface) (*Repository, error)
+	// NetworkRepos inspects the parent/fork relationship between repositories.
+	NetworkRepos(repos []ghrepo.Interface) (RepoNetworkResult, error)
+	// ForkRepo forks a repository and returns the newly created fork.
+	ForkRepo(repo ghrepo.Interface) (*Repository, error)
+	// CreateRepo creates a new repository.
+	CreateRepo(input RepoCreateInput) (*Repository, error)
+}
+
+// DetectForge guesses the ForgeType of a repository from its host name.
+// Self-hosted GitLab, Gitea, and Bitbucket Server instances are
+// indistinguishable from a hostname alone, so an unrecognized host returns
+// ForgeUnknown rather than guessing; callers must fall back to asking the
+// user to pick explicitly via `--forge` in that case.
+func DetectForge(host string) ForgeType {
+	switch host {
+	case "github.com", "":
+		return ForgeGitHub
+	case "gitlab.com":
+		return ForgeGitLab
+	default:
+		return ForgeUnknown
+	}
+}
+
+// NewForgeClient builds the ForgeClient implementation for the given forge.
+// host and token configure access to self-hosted GitLab, Gitea, and
+// Bitbucket Server instances; they are ignored for ForgeGitHub, which talks
+// to github.com (or the Enterprise host already configured on client).
+// ForgeUnknown (including DetectForge's result for an unrecognized host) is
+// rejected rather than defaulted to GitHub; callers must resolve it to a
+// concrete ForgeType first, e.g. via an explicit `--forge` flag.
+func NewForgeClient(forge ForgeType, client *Client, host, token string) (ForgeClient, error) {
+	switch forge {
+	case ForgeGitHub:
+		return &githubForge{client: client}, nil
+	case ForgeGitLab:
+		return newGitLabForge(host, token), nil
+	case ForgeGitea:
+		return newGiteaForge(host, token), nil
+	case ForgeBitbucket:
+		return newBitbucketForge(host, token), nil
+	case ForgeUnknown:
+		return nil, fmt.Errorf("could not determine which forge %q uses; specify one explicitly with --forge", host)
+	default:
+		return nil, fmt.Errorf("unsupported forge %q", forge)
+	}
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// bitbucketForge implements ForgeClient against a Bitbucket Server (Data
+// Center) instance using its REST API under /rest/api/1.0. Bitbucket Server
+// has no fork-by-API-call-alone concept of a flat owner/name the way GitHub
+// does; repositories are addressed by project key and slug, so we treat
+// repo.RepoOwner() as the project key.
+type bitbucketForge struct {
+	rest *forgeRESTClient
+}
+
+func newBitbucketForge(host, token string) *bitbucketForge {
+	return &bitbucketForge{
+		rest: &forgeRESTClient{
+			baseURL: fmt.Sprintf("https://%s/rest/api/1.0", host),
+			http:    http.DefaultClient,
+			auth: func(r *http.Request) {
+				r.Header.Set("Authorization", "Bearer "+token)
+			},
+		},
+	}
+}
+
+type bitbucketRepository struct {
+	Name    string `json:"name"`
+	Slug    string `json:"slug"`
+	Project struct {
+		Key string `json:"key"`
+	} `json:"project"`
+	Public bool `json:"public"`
+	Links  struct {
+		Clone []struct {
+			Name string `json:"name"`
+			HRef string `json:"href"`
+		} `json:"clone"`
+		Self []struct {
+			HRef string `json:"href"`
+		} `json:"self"`
+	} `json:"links"`
+	Origin *bitbucketRepository `json:"origin"`
+}
+
+func (b *bitbucketRepository) toRepository() *Repository {
+	if b == nil {
+		return nil
+	}
+	r := &Repository{
+		Name:      b.Name,
+		IsPrivate: !b.Public,
+		Owner:     RepositoryOwner{Login: b.Project.Key},
+		// Bitbucket Server doesn't return a repository creation timestamp
+		// from this endpoint.
+		CreatedAt: time.Time{},
+		Parent:    b.Origin.toRepository(),
+	}
+	for _, link := range b.Links.Clone {
+		if link.Name == "http" || link.Name == "https" {
+			r.CloneURL = link.HRef
+		}
+	}
+	if len(b.Links.Self) > 0 {
+		r.URL = b.Links.Self[0].HRef
+	}
+	return r
+}
+
+func (f *bitbucketForge) LookupRepo(repo ghrepo.Interface) (*Repository, error) {
+	var result bitbucketRepository
+	path := fmt.Sprintf("/projects/%s/repos/%s", repo.RepoOwner(), repo.RepoName())
+	if err := f.rest.do("GET", path, nil, &result); err != nil {
+		return nil, err
+	}
+	return result.toRepository(), nil
+}
+
+func (f *bitbucketForge) NetworkRepos(repos []ghrepo.Interface) (RepoNetworkResult, error) {
+	return networkRepos(repos, f.LookupRepo)
+}
+
+func (f *bitbucketForge) ForkRepo(repo ghrepo.Interface) (*Repository, error) {
+	var result bitbucketRepository
+	path := fmt.Sprintf("/projects/%s/repos/%s", repo.RepoOwner(), repo.RepoName())
+	if err := f.rest.do("POST", path, map[string]interface{}{}, &result); err != nil {
+		return nil, err
+	}
+	return result.toRepository(), nil
+}
+
+func (f *bitbucketForge) CreateRepo(input RepoCreateInput) (*Repository, error) {
+	var result bitbucketRepository
+	body := map[string]interface{}{
+		"name":   input.Name,
+		"scmId":  "git",
+		"public": input.Visibility != "PRIVATE",
+	}
+	path := fmt.Sprintf("/projects/%s/repos", input.OwnerID)
+	if err := f.rest.do("POST", path, body, &result); err != nil {
+		return nil, err
+	}
+	return result.toRepository(), nil
+}
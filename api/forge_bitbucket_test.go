@@ -0,0 +1,49 @@
+package api
+
+import "testing"
+
+func TestBitbucketRepositoryToRepository(t *testing.T) {
+	repo := &bitbucketRepository{
+		Name:   "cli",
+		Public: false,
+	}
+	repo.Project.Key = "ACME"
+	repo.Links.Clone = []struct {
+		Name string `json:"name"`
+		HRef string `json:"href"`
+	}{
+		{Name: "ssh", HRef: "ssh://git@bitbucket.example.com/acme/cli.git"},
+		{Name: "https", HRef: "https://bitbucket.example.com/scm/acme/cli.git"},
+	}
+	repo.Links.Self = []struct {
+		HRef string `json:"href"`
+	}{
+		{HRef: "https://bitbucket.example.com/projects/ACME/repos/cli"},
+	}
+	repo.Origin = &bitbucketRepository{Name: "upstream-cli"}
+
+	result := repo.toRepository()
+
+	if result.Name != "cli" || result.Owner.Login != "ACME" {
+		t.Errorf("unexpected repo identity: %+v", result)
+	}
+	if !result.IsPrivate {
+		t.Error("expected IsPrivate to be true when public=false")
+	}
+	if result.CloneURL != "https://bitbucket.example.com/scm/acme/cli.git" {
+		t.Errorf("expected https clone link to be selected, got %q", result.CloneURL)
+	}
+	if result.URL != "https://bitbucket.example.com/projects/ACME/repos/cli" {
+		t.Errorf("unexpected URL: %q", result.URL)
+	}
+	if result.Parent == nil || result.Parent.Name != "upstream-cli" {
+		t.Errorf("expected origin to map to Parent, got %+v", result.Parent)
+	}
+}
+
+func TestBitbucketRepositoryToRepository_nil(t *testing.T) {
+	var repo *bitbucketRepository
+	if result := repo.toRepository(); result != nil {
+		t.Errorf("expected nil Repository for nil input, got %+v", result)
+	}
+}
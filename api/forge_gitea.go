@@ -0,0 +1,104 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// giteaForge implements ForgeClient against a Gitea instance using Gitea's
+// REST v1 API, which closely mirrors GitHub's v3 REST API.
+type giteaForge struct {
+	rest *forgeRESTClient
+}
+
+func newGiteaForge(host, token string) *giteaForge {
+	return &giteaForge{
+		rest: &forgeRESTClient{
+			baseURL: fmt.Sprintf("https://%s/api/v1", host),
+			http:    http.DefaultClient,
+			auth: func(r *http.Request) {
+				r.Header.Set("Authorization", "token "+token)
+			},
+		},
+	}
+}
+
+type giteaRepository struct {
+	Name        string    `json:"name"`
+	HTMLURL     string    `json:"html_url"`
+	CloneURL    string    `json:"clone_url"`
+	Created     time.Time `json:"created_at"`
+	Private     bool      `json:"private"`
+	HasIssues   bool      `json:"has_issues"`
+	Permissions struct {
+		Push bool `json:"push"`
+	} `json:"permissions"`
+	DefaultBranch string `json:"default_branch"`
+	Owner         struct {
+		Login string `json:"login"`
+	} `json:"owner"`
+	Fork   bool             `json:"fork"`
+	Parent *giteaRepository `json:"parent"`
+}
+
+func (g *giteaRepository) toRepository() *Repository {
+	if g == nil {
+		return nil
+	}
+	r := &Repository{
+		Name:             g.Name,
+		URL:              g.HTMLURL,
+		CloneURL:         g.CloneURL,
+		CreatedAt:        g.Created,
+		Owner:            RepositoryOwner{Login: g.Owner.Login},
+		IsPrivate:        g.Private,
+		HasIssuesEnabled: g.HasIssues,
+		Parent:           g.Parent.toRepository(),
+	}
+	r.DefaultBranchRef.Name = g.DefaultBranch
+	if g.Permissions.Push {
+		r.ViewerPermission = "WRITE"
+	}
+	return r
+}
+
+func (f *giteaForge) LookupRepo(repo ghrepo.Interface) (*Repository, error) {
+	var result giteaRepository
+	path := fmt.Sprintf("/repos/%s/%s", repo.RepoOwner(), repo.RepoName())
+	if err := f.rest.do("GET", path, nil, &result); err != nil {
+		return nil, err
+	}
+	return result.toRepository(), nil
+}
+
+func (f *giteaForge) NetworkRepos(repos []ghrepo.Interface) (RepoNetworkResult, error) {
+	return networkRepos(repos, f.LookupRepo)
+}
+
+func (f *giteaForge) ForkRepo(repo ghrepo.Interface) (*Repository, error) {
+	var result giteaRepository
+	path := fmt.Sprintf("/repos/%s/%s/forks", repo.RepoOwner(), repo.RepoName())
+	if err := f.rest.do("POST", path, map[string]interface{}{}, &result); err != nil {
+		return nil, err
+	}
+	return result.toRepository(), nil
+}
+
+func (f *giteaForge) CreateRepo(input RepoCreateInput) (*Repository, error) {
+	var result giteaRepository
+	body := map[string]interface{}{
+		"name":        input.Name,
+		"description": input.Description,
+		"private":     input.Visibility == "PRIVATE",
+		"auto_init":   false,
+		"has_issues":  input.HasIssuesEnabled,
+		"has_wiki":    input.HasWikiEnabled,
+	}
+	if err := f.rest.do("POST", "/user/repos", body, &result); err != nil {
+		return nil, err
+	}
+	return result.toRepository(), nil
+}
@@ -0,0 +1,39 @@
+package api
+
+import "testing"
+
+func TestGiteaRepositoryToRepository(t *testing.T) {
+	repo := &giteaRepository{
+		Name:          "cli",
+		HTMLURL:       "https://gitea.example.com/acme/cli",
+		CloneURL:      "https://gitea.example.com/acme/cli.git",
+		Private:       true,
+		HasIssues:     true,
+		DefaultBranch: "main",
+	}
+	repo.Owner.Login = "acme"
+	repo.Permissions.Push = true
+	repo.Parent = &giteaRepository{Name: "upstream-cli"}
+
+	result := repo.toRepository()
+
+	if result.Name != "cli" || result.Owner.Login != "acme" {
+		t.Errorf("unexpected repo identity: %+v", result)
+	}
+	if !result.IsPrivate {
+		t.Error("expected IsPrivate to be true")
+	}
+	if result.ViewerPermission != "WRITE" {
+		t.Errorf("expected ViewerPermission=WRITE when permissions.push is true, got %q", result.ViewerPermission)
+	}
+	if result.Parent == nil || result.Parent.Name != "upstream-cli" {
+		t.Errorf("expected parent to map to Parent, got %+v", result.Parent)
+	}
+}
+
+func TestGiteaRepositoryToRepository_nil(t *testing.T) {
+	var repo *giteaRepository
+	if result := repo.toRepository(); result != nil {
+		t.Errorf("expected nil Repository for nil input, got %+v", result)
+	}
+}
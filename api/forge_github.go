@@ -0,0 +1,26 @@
+package api
+
+import "github.com/cli/cli/internal/ghrepo"
+
+// githubForge implements ForgeClient against github.com (or a GitHub
+// Enterprise host) by delegating to the existing GraphQL/REST-backed
+// Repository operations.
+type githubForge struct {
+	client *Client
+}
+
+func (f *githubForge) LookupRepo(repo ghrepo.Interface) (*Repository, error) {
+	return GitHubRepo(f.client, repo)
+}
+
+func (f *githubForge) NetworkRepos(repos []ghrepo.Interface) (RepoNetworkResult, error) {
+	return RepoNetwork(f.client, repos)
+}
+
+func (f *githubForge) ForkRepo(repo ghrepo.Interface) (*Repository, error) {
+	return ForkRepo(f.client, repo)
+}
+
+func (f *githubForge) CreateRepo(input RepoCreateInput) (*Repository, error) {
+	return RepoCreate(f.client, input)
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// gitlabForge implements ForgeClient against a GitLab instance (gitlab.com
+// or self-hosted) using GitLab's REST v4 API.
+type gitlabForge struct {
+	rest *forgeRESTClient
+}
+
+func newGitLabForge(host, token string) *gitlabForge {
+	if host == "" {
+		host = "gitlab.com"
+	}
+	return &gitlabForge{
+		rest: &forgeRESTClient{
+			baseURL: fmt.Sprintf("https://%s/api/v4", host),
+			http:    http.DefaultClient,
+			auth: func(r *http.Request) {
+				r.Header.Set("PRIVATE-TOKEN", token)
+			},
+		},
+	}
+}
+
+type gitlabProject struct {
+	ID            int       `json:"id"`
+	Name          string    `json:"name"`
+	WebURL        string    `json:"web_url"`
+	HTTPURLToRepo string    `json:"http_url_to_repo"`
+	CreatedAt     time.Time `json:"created_at"`
+	Visibility    string    `json:"visibility"`
+	IssuesEnabled bool      `json:"issues_enabled"`
+	DefaultBranch string    `json:"default_branch"`
+	Namespace     struct {
+		Path string `json:"path"`
+	} `json:"namespace"`
+	Permissions struct {
+		ProjectAccess *struct {
+			AccessLevel int `json:"access_level"`
+		} `json:"project_access"`
+	} `json:"permissions"`
+	ForkedFromProject *gitlabProject `json:"forked_from_project"`
+}
+
+// gitlabDeveloperAccess is the minimum GitLab access level that maps to
+// push/write access in our Repository model.
+// https://docs.gitlab.com/ee/api/members.html#valid-access-levels
+const gitlabDeveloperAccess = 30
+
+func (p *gitlabProject) toRepository() *Repository {
+	if p == nil {
+		return nil
+	}
+	r := &Repository{
+		ID:               fmt.Sprintf("%d", p.ID),
+		Name:             p.Name,
+		URL:              p.WebURL,
+		CloneURL:         p.HTTPURLToRepo,
+		CreatedAt:        p.CreatedAt,
+		Owner:            RepositoryOwner{Login: p.Namespace.Path},
+		IsPrivate:        p.Visibility != "public",
+		HasIssuesEnabled: p.IssuesEnabled,
+		Parent:           p.ForkedFromProject.toRepository(),
+	}
+	r.DefaultBranchRef.Name = p.DefaultBranch
+	if p.Permissions.ProjectAccess != nil && p.Permissions.ProjectAccess.AccessLevel >= gitlabDeveloperAccess {
+		r.ViewerPermission = "WRITE"
+	}
+	return r
+}
+
+func (f *gitlabForge) LookupRepo(repo ghrepo.Interface) (*Repository, error) {
+	var project gitlabProject
+	path := fmt.Sprintf("/projects/%s", url.PathEscape(ghrepo.FullName(repo)))
+	if err := f.rest.do("GET", path, nil, &project); err != nil {
+		return nil, err
+	}
+	return project.toRepository(), nil
+}
+
+func (f *gitlabForge) NetworkRepos(repos []ghrepo.Interface) (RepoNetworkResult, error) {
+	return networkRepos(repos, f.LookupRepo)
+}
+
+func (f *gitlabForge) ForkRepo(repo ghrepo.Interface) (*Repository, error) {
+	var project gitlabProject
+	path := fmt.Sprintf("/projects/%s/fork", url.PathEscape(ghrepo.FullName(repo)))
+	if err := f.rest.do("POST", path, nil, &project); err != nil {
+		return nil, err
+	}
+	return project.toRepository(), nil
+}
+
+func (f *gitlabForge) CreateRepo(input RepoCreateInput) (*Repository, error) {
+	var project gitlabProject
+	body := map[string]interface{}{
+		"name":        input.Name,
+		"description": input.Description,
+		"visibility":  strings.ToLower(input.Visibility),
+	}
+	if err := f.rest.do("POST", "/projects", body, &project); err != nil {
+		return nil, err
+	}
+	return project.toRepository(), nil
+}
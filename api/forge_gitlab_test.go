@@ -0,0 +1,42 @@
+package api
+
+import "testing"
+
+func TestGitlabProjectToRepository(t *testing.T) {
+	project := &gitlabProject{
+		ID:            42,
+		Name:          "cli",
+		WebURL:        "https://gitlab.example.com/acme/cli",
+		HTTPURLToRepo: "https://gitlab.example.com/acme/cli.git",
+		Visibility:    "private",
+		IssuesEnabled: true,
+		DefaultBranch: "main",
+	}
+	project.Namespace.Path = "acme"
+	project.Permissions.ProjectAccess = &struct {
+		AccessLevel int `json:"access_level"`
+	}{AccessLevel: gitlabDeveloperAccess}
+	project.ForkedFromProject = &gitlabProject{Name: "upstream-cli"}
+
+	repo := project.toRepository()
+
+	if repo.Name != "cli" || repo.Owner.Login != "acme" {
+		t.Errorf("unexpected repo identity: %+v", repo)
+	}
+	if !repo.IsPrivate {
+		t.Error("expected IsPrivate to be true for visibility=private")
+	}
+	if repo.ViewerPermission != "WRITE" {
+		t.Errorf("expected ViewerPermission=WRITE at developer access level, got %q", repo.ViewerPermission)
+	}
+	if repo.Parent == nil || repo.Parent.Name != "upstream-cli" {
+		t.Errorf("expected forked_from_project to map to Parent, got %+v", repo.Parent)
+	}
+}
+
+func TestGitlabProjectToRepository_nil(t *testing.T) {
+	var project *gitlabProject
+	if repo := project.toRepository(); repo != nil {
+		t.Errorf("expected nil Repository for nil project, got %+v", repo)
+	}
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// forgeRESTClient is a minimal JSON REST client shared by the self-hosted
+// forge implementations (GitLab, Gitea, Bitbucket Server), which don't get
+// the benefit of the GraphQL-aware Client used for github.com.
+type forgeRESTClient struct {
+	baseURL string
+	http    *http.Client
+	auth    func(*http.Request)
+}
+
+// forgeHTTPError is returned by forgeRESTClient.do when a request completes
+// but the forge responds with a non-2xx status, so callers can distinguish
+// "not found" from other failures (auth, rate limit, server error) instead
+// of treating every REST error the same way.
+type forgeHTTPError struct {
+	StatusCode int
+	Path       string
+	Body       string
+}
+
+func (e *forgeHTTPError) Error() string {
+	return fmt.Sprintf("request to %s failed (%d): %s", e.Path, e.StatusCode, e.Body)
+}
+
+// isForgeNotFound reports whether err is a forgeHTTPError for a 404
+// response, the only REST failure that RepoNetwork-style lookups are safe
+// to swallow into a nil result.
+func isForgeNotFound(err error) bool {
+	httpErr, ok := err.(*forgeHTTPError)
+	return ok && httpErr.StatusCode == 404
+}
+
+// networkRepos looks up each of repos in turn via lookup, tolerating a 404
+// from any individual lookup into a nil entry instead of failing the whole
+// batch, the same way RepoNetwork tolerates NOT_FOUND aliases for GitHub.
+// It's shared by the REST-based forges (GitLab, Gitea, Bitbucket Server),
+// which have no GraphQL-style batching to fall back on.
+func networkRepos(repos []ghrepo.Interface, lookup func(ghrepo.Interface) (*Repository, error)) (RepoNetworkResult, error) {
+	var result RepoNetworkResult
+	for _, repo := range repos {
+		r, err := lookup(repo)
+		if err != nil {
+			if isForgeNotFound(err) {
+				result.Repositories = append(result.Repositories, nil)
+				continue
+			}
+			return result, err
+		}
+		result.Repositories = append(result.Repositories, r)
+	}
+	return result, nil
+}
+
+func (c *forgeRESTClient) do(method, path string, body interface{}, out interface{}) error {
+	var reqBody *bytes.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	} else {
+		reqBody = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.auth != nil {
+		c.auth(req)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return &forgeHTTPError{StatusCode: resp.StatusCode, Path: path, Body: string(bytes.TrimSpace(b))}
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
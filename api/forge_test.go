@@ -0,0 +1,37 @@
+package api
+
+import "testing"
+
+func TestDetectForge(t *testing.T) {
+	tests := []struct {
+		host string
+		want ForgeType
+	}{
+		{"github.com", ForgeGitHub},
+		{"", ForgeGitHub},
+		{"gitlab.com", ForgeGitLab},
+		{"git.example.com", ForgeUnknown},
+	}
+	for _, tt := range tests {
+		if got := DetectForge(tt.host); got != tt.want {
+			t.Errorf("DetectForge(%q) = %q, want %q", tt.host, got, tt.want)
+		}
+	}
+}
+
+func TestNewForgeClient_unknownRequiresExplicitForge(t *testing.T) {
+	_, err := NewForgeClient(ForgeUnknown, nil, "git.example.com", "")
+	if err == nil {
+		t.Fatal("expected an error for ForgeUnknown, got nil")
+	}
+}
+
+func TestNewForgeClient_github(t *testing.T) {
+	client, err := NewForgeClient(ForgeGitHub, &Client{}, "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := client.(*githubForge); !ok {
+		t.Errorf("expected a *githubForge, got %T", client)
+	}
+}
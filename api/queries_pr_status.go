@@ -0,0 +1,287 @@
+package api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// CheckState is the unified pass/fail/pending state of a single check,
+// merging GitHub Actions check runs and legacy commit statuses into one
+// vocabulary.
+type CheckState string
+
+const (
+	CheckStateSuccess CheckState = "SUCCESS"
+	CheckStateFailure CheckState = "FAILURE"
+	CheckStatePending CheckState = "PENDING"
+)
+
+// CheckResult is a single check run or status context reported against a
+// pull request's head commit.
+type CheckResult struct {
+	Name       string
+	State      CheckState
+	StartedAt  time.Time
+	DetailsURL string
+}
+
+// Checks is the rollup of every check run and status context reported
+// against a pull request's head commit.
+type Checks struct {
+	State   CheckState
+	Results []CheckResult
+}
+
+// PullRequest contains the information needed to report a pull request's
+// review and checks status.
+type PullRequest struct {
+	ID             string
+	Number         int
+	Title          string
+	State          string
+	BaseRefName    string
+	HeadRefName    string
+	URL            string
+	Mergeable      string
+	ReviewDecision string
+
+	Checks Checks
+}
+
+// pullRequestStatusFragment is the shape of the GraphQL query below; its
+// commits/statusCheckRollup connections get collapsed into Checks by
+// rollupChecks (via export).
+type pullRequestStatusFragment struct {
+	PullRequest
+	Commits struct {
+		Nodes []struct {
+			Commit struct {
+				StatusCheckRollup struct {
+					Contexts struct {
+						Nodes []checkContextNode
+					}
+				}
+			}
+		}
+	}
+}
+
+type checkContextNode struct {
+	Typename string `json:"__typename"`
+
+	// CheckRun fields
+	Name       string
+	Status     string
+	Conclusion string
+	DetailsURL string `json:"detailsUrl"`
+	StartedAt  time.Time
+
+	// StatusContext fields
+	Context   string
+	State     string
+	TargetURL string `json:"targetUrl"`
+	CreatedAt time.Time
+}
+
+func (n checkContextNode) toCheckResult() CheckResult {
+	switch n.Typename {
+	case "CheckRun":
+		return CheckResult{
+			Name:       n.Name,
+			State:      checkRunState(n.Status, n.Conclusion),
+			StartedAt:  n.StartedAt,
+			DetailsURL: n.DetailsURL,
+		}
+	default: // StatusContext
+		return CheckResult{
+			Name:       n.Context,
+			State:      statusContextState(n.State),
+			StartedAt:  n.CreatedAt,
+			DetailsURL: n.TargetURL,
+		}
+	}
+}
+
+func checkRunState(status, conclusion string) CheckState {
+	if status != "COMPLETED" {
+		return CheckStatePending
+	}
+	switch conclusion {
+	case "SUCCESS", "NEUTRAL", "SKIPPED":
+		return CheckStateSuccess
+	default:
+		return CheckStateFailure
+	}
+}
+
+func statusContextState(state string) CheckState {
+	switch state {
+	case "SUCCESS":
+		return CheckStateSuccess
+	case "PENDING", "EXPECTED":
+		return CheckStatePending
+	default:
+		return CheckStateFailure
+	}
+}
+
+func (f pullRequestStatusFragment) export() *PullRequest {
+	pr := f.PullRequest
+	if len(f.Commits.Nodes) > 0 {
+		pr.Checks = rollupChecks(f.Commits.Nodes[0].Commit.StatusCheckRollup.Contexts.Nodes)
+	}
+	return &pr
+}
+
+// rollupChecks merges individual check runs and status contexts into a
+// single pass/fail/pending state: any failure fails the rollup, otherwise
+// any pending check leaves it pending, otherwise it's a success.
+func rollupChecks(nodes []checkContextNode) Checks {
+	checks := Checks{State: CheckStateSuccess}
+	sawPending := false
+	for _, n := range nodes {
+		result := n.toCheckResult()
+		checks.Results = append(checks.Results, result)
+		switch result.State {
+		case CheckStateFailure:
+			checks.State = CheckStateFailure
+		case CheckStatePending:
+			sawPending = true
+		}
+	}
+	if checks.State != CheckStateFailure && sawPending {
+		checks.State = CheckStatePending
+	}
+	return checks
+}
+
+const pullRequestStatusFields = `
+	id
+	number
+	title
+	state
+	baseRefName
+	headRefName
+	url
+	mergeable
+	reviewDecision
+	commits(last: 1) {
+		nodes {
+			commit {
+				statusCheckRollup {
+					contexts(first: 100) {
+						nodes {
+							__typename
+							... on CheckRun {
+								name
+								status
+								conclusion
+								detailsUrl
+								startedAt
+							}
+							... on StatusContext {
+								context
+								state
+								targetUrl
+								createdAt
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+`
+
+// PullRequestStatus fetches a pull request's review state together with its
+// GitHub Actions check-run and legacy status-context results in a single
+// GraphQL round trip, rather than the serial REST calls `pr status`/`pr
+// checks` historically required.
+func PullRequestStatus(client *Client, repo ghrepo.Interface, prNumber int) (*PullRequest, error) {
+	query := fmt.Sprintf(`
+	query($owner: String!, $repo: String!, $number: Int!) {
+		repository(owner: $owner, name: $repo) {
+			pullRequest(number: $number) {
+				%s
+			}
+		}
+	}`, pullRequestStatusFields)
+
+	variables := map[string]interface{}{
+		"owner":  repo.RepoOwner(),
+		"repo":   repo.RepoName(),
+		"number": prNumber,
+	}
+
+	var result struct {
+		Repository struct {
+			PullRequest pullRequestStatusFragment
+		}
+	}
+	if err := client.GraphQL(query, variables, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Repository.PullRequest.export(), nil
+}
+
+// PullRequestListOptions filters the pull requests returned by PullRequestList.
+type PullRequestListOptions struct {
+	State      string // OPEN, CLOSED, MERGED, or "" for any
+	BaseBranch string
+	Limit      int
+}
+
+// PullRequestList fetches a page of a repository's pull requests, each with
+// its checks rollup already resolved, in a single GraphQL round trip.
+func PullRequestList(client *Client, repo ghrepo.Interface, opts PullRequestListOptions) ([]PullRequest, error) {
+	limit := opts.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+
+	query := fmt.Sprintf(`
+	query($owner: String!, $repo: String!, $limit: Int!, $baseRefName: String, $states: [PullRequestState!]) {
+		repository(owner: $owner, name: $repo) {
+			pullRequests(first: $limit, baseRefName: $baseRefName, states: $states, orderBy: {field: CREATED_AT, direction: DESC}) {
+				nodes {
+					%s
+				}
+			}
+		}
+	}`, pullRequestStatusFields)
+
+	variables := map[string]interface{}{
+		"owner":       repo.RepoOwner(),
+		"repo":        repo.RepoName(),
+		"limit":       limit,
+		"baseRefName": opts.BaseBranch,
+		"states":      pullRequestStateFilter(opts.State),
+	}
+
+	var result struct {
+		Repository struct {
+			PullRequests struct {
+				Nodes []pullRequestStatusFragment
+			}
+		}
+	}
+	if err := client.GraphQL(query, variables, &result); err != nil {
+		return nil, err
+	}
+
+	prs := make([]PullRequest, 0, len(result.Repository.PullRequests.Nodes))
+	for _, node := range result.Repository.PullRequests.Nodes {
+		prs = append(prs, *node.export())
+	}
+	return prs, nil
+}
+
+func pullRequestStateFilter(state string) interface{} {
+	if state == "" {
+		return nil
+	}
+	return []string{state}
+}
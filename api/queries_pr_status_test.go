@@ -0,0 +1,74 @@
+package api
+
+import "testing"
+
+func TestCheckRunState(t *testing.T) {
+	tests := []struct {
+		status     string
+		conclusion string
+		want       CheckState
+	}{
+		{"IN_PROGRESS", "", CheckStatePending},
+		{"COMPLETED", "SUCCESS", CheckStateSuccess},
+		{"COMPLETED", "NEUTRAL", CheckStateSuccess},
+		{"COMPLETED", "SKIPPED", CheckStateSuccess},
+		{"COMPLETED", "FAILURE", CheckStateFailure},
+		{"COMPLETED", "CANCELLED", CheckStateFailure},
+	}
+	for _, tt := range tests {
+		if got := checkRunState(tt.status, tt.conclusion); got != tt.want {
+			t.Errorf("checkRunState(%q, %q) = %q, want %q", tt.status, tt.conclusion, got, tt.want)
+		}
+	}
+}
+
+func TestStatusContextState(t *testing.T) {
+	tests := []struct {
+		state string
+		want  CheckState
+	}{
+		{"SUCCESS", CheckStateSuccess},
+		{"PENDING", CheckStatePending},
+		{"EXPECTED", CheckStatePending},
+		{"ERROR", CheckStateFailure},
+		{"FAILURE", CheckStateFailure},
+	}
+	for _, tt := range tests {
+		if got := statusContextState(tt.state); got != tt.want {
+			t.Errorf("statusContextState(%q) = %q, want %q", tt.state, got, tt.want)
+		}
+	}
+}
+
+func TestRollupChecks(t *testing.T) {
+	t.Run("all success", func(t *testing.T) {
+		nodes := []checkContextNode{
+			{Typename: "CheckRun", Name: "build", Status: "COMPLETED", Conclusion: "SUCCESS"},
+			{Typename: "StatusContext", Context: "ci/lint", State: "SUCCESS"},
+		}
+		if got := rollupChecks(nodes).State; got != CheckStateSuccess {
+			t.Errorf("expected overall state SUCCESS, got %q", got)
+		}
+	})
+
+	t.Run("any failure fails the rollup", func(t *testing.T) {
+		nodes := []checkContextNode{
+			{Typename: "CheckRun", Name: "build", Status: "COMPLETED", Conclusion: "SUCCESS"},
+			{Typename: "CheckRun", Name: "test", Status: "COMPLETED", Conclusion: "FAILURE"},
+			{Typename: "StatusContext", Context: "ci/lint", State: "PENDING"},
+		}
+		if got := rollupChecks(nodes).State; got != CheckStateFailure {
+			t.Errorf("expected overall state FAILURE, got %q", got)
+		}
+	})
+
+	t.Run("pending without failure stays pending", func(t *testing.T) {
+		nodes := []checkContextNode{
+			{Typename: "CheckRun", Name: "build", Status: "IN_PROGRESS"},
+			{Typename: "StatusContext", Context: "ci/lint", State: "SUCCESS"},
+		}
+		if got := rollupChecks(nodes).State; got != CheckStatePending {
+			t.Errorf("expected overall state PENDING, got %q", got)
+		}
+	})
+}
@@ -2,7 +2,6 @@ package api
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -17,10 +16,29 @@ type Repository struct {
 	Name      string
 	URL       string
 	CloneURL  string
+	SSHURL    string
+	MirrorURL string
 	CreatedAt time.Time
+	PushedAt  time.Time
+	UpdatedAt time.Time
 	Owner     RepositoryOwner
 
+	Description string
+	Homepage    string
+	Topics      []string
+
+	Language        string
+	PrimaryLanguage *RepositoryLanguage
+	LicenseInfo     *RepositoryLicense
+
+	StargazerCount  int
+	ForkCount       int
+	OpenIssuesCount int
+
 	IsPrivate        bool
+	IsArchived       bool
+	IsTemplate       bool
+	IsMirror         bool
 	HasIssuesEnabled bool
 	ViewerPermission string
 	DefaultBranchRef struct {
@@ -31,6 +49,12 @@ type Repository struct {
 	}
 
 	Parent *Repository
+
+	// Forks and Collaborators are only populated by RepoList, which expands
+	// both connections past their first page via a follow-up cursor query
+	// when a repository has more of either than fit in the initial page.
+	Forks         []Repository
+	Collaborators []string
 }
 
 // RepositoryOwner is the owner of a GitHub repository
@@ -38,6 +62,29 @@ type RepositoryOwner struct {
 	Login string
 }
 
+// RepositoryLanguage is a single language GitHub detected in a repository
+type RepositoryLanguage struct {
+	Name string
+}
+
+// RepositoryLicense describes the license a repository is released under
+type RepositoryLicense struct {
+	Key  string
+	Name string
+}
+
+// syncLanguage fills in whichever of Language/PrimaryLanguage is still
+// empty from the other, since the GraphQL and REST APIs each only populate
+// one of the two natively.
+func (r *Repository) syncLanguage() {
+	switch {
+	case r.Language == "" && r.PrimaryLanguage != nil:
+		r.Language = r.PrimaryLanguage.Name
+	case r.Language != "" && r.PrimaryLanguage == nil:
+		r.PrimaryLanguage = &RepositoryLanguage{Name: r.Language}
+	}
+}
+
 // RepoOwner is the login name of the owner
 func (r Repository) RepoOwner() string {
 	return r.Owner.Login
@@ -69,6 +116,25 @@ func GitHubRepo(client *Client, repo ghrepo.Interface) (*Repository, error) {
 		repository(owner: $owner, name: $name) {
 			id
 			hasIssuesEnabled
+			description
+			homepage: homepageUrl
+			sshUrl
+			mirrorUrl
+			pushedAt
+			updatedAt
+			isArchived
+			isTemplate
+			isMirror
+			stargazerCount
+			forkCount
+			openIssuesCount: issues(states: OPEN) { totalCount }
+			primaryLanguage { name }
+			licenseInfo { key name }
+			repositoryTopics(first: 25) {
+				nodes {
+					topic { name }
+				}
+			}
 		}
 	}`
 	variables := map[string]interface{}{
@@ -77,7 +143,7 @@ func GitHubRepo(client *Client, repo ghrepo.Interface) (*Repository, error) {
 	}
 
 	result := struct {
-		Repository Repository
+		Repository repositoryGraphQL
 	}{}
 	err := client.GraphQL(query, variables, &result)
 
@@ -85,7 +151,34 @@ func GitHubRepo(client *Client, repo ghrepo.Interface) (*Repository, error) {
 		return nil, err
 	}
 
-	return &result.Repository, nil
+	return result.Repository.export(), nil
+}
+
+// repositoryGraphQL mirrors the shape of GitHub's GraphQL repository object,
+// which doesn't decode directly into Repository because connections like
+// repositoryTopics and issues need to be flattened into simpler fields.
+type repositoryGraphQL struct {
+	Repository
+	OpenIssuesCount struct {
+		TotalCount int
+	} `json:"openIssuesCount"`
+	RepositoryTopics struct {
+		Nodes []struct {
+			Topic struct {
+				Name string
+			}
+		}
+	}
+}
+
+func (r repositoryGraphQL) export() *Repository {
+	repo := r.Repository
+	repo.OpenIssuesCount = r.OpenIssuesCount.TotalCount
+	for _, node := range r.RepositoryTopics.Nodes {
+		repo.Topics = append(repo.Topics, node.Topic.Name)
+	}
+	repo.syncLanguage()
+	return &repo
 }
 
 // RepoNetworkResult describes the relationship between related repositories
@@ -108,13 +201,7 @@ func RepoNetwork(client *Client, repos []ghrepo.Interface) (RepoNetworkResult, e
 		`, i, repo.RepoOwner(), repo.RepoName()))
 	}
 
-	// Since the query is constructed dynamically, we can't parse a response
-	// format using a static struct. Instead, hold the raw JSON data until we
-	// decide how to parse it manually.
-	graphqlResult := make(map[string]*json.RawMessage)
-	var result RepoNetworkResult
-
-	err := client.GraphQL(fmt.Sprintf(`
+	query := fmt.Sprintf(`
 	fragment repo on Repository {
 		id
 		name
@@ -130,53 +217,45 @@ func RepoNetwork(client *Client, repos []ghrepo.Interface) (RepoNetworkResult, e
 		viewer { login }
 		%s
 	}
-	`, strings.Join(queries, "")), nil, &graphqlResult)
-	graphqlError, isGraphQLError := err.(*GraphQLErrorResponse)
-	if isGraphQLError {
-		// If the only errors are that certain repositories are not found,
-		// continue processing this response instead of returning an error
-		tolerated := true
-		for _, ge := range graphqlError.Errors {
-			if ge.Type != "NOT_FOUND" {
-				tolerated = false
-			}
-		}
-		if tolerated {
-			err = nil
-		}
-	}
+	`, strings.Join(queries, ""))
+
+	// Tolerate NOT_FOUND per-alias failures instead of aborting the whole
+	// batch: some repositories in the requested set (e.g. a deleted fork
+	// parent) may no longer exist.
+	results, err := BatchGraphQL(client, query, nil, BatchGraphQLOptions{
+		Tolerate: func(class ErrorClass) bool { return class == ErrorNotFound },
+	})
+	var result RepoNetworkResult
 	if err != nil {
 		return result, err
 	}
 
-	keys := make([]string, 0, len(graphqlResult))
-	for key := range graphqlResult {
+	keys := make([]string, 0, len(results))
+	for key := range results {
 		keys = append(keys, key)
 	}
 	// sort keys to ensure `repo_{N}` entries are processed in order
 	sort.Sort(sort.StringSlice(keys))
 
-	// Iterate over keys of GraphQL response data and, based on its name,
-	// dynamically allocate the target struct an individual message gets decoded to.
+	// Iterate over keys of the GraphQL response and, based on its name,
+	// dynamically allocate the target struct an individual result gets decoded to.
 	for _, name := range keys {
-		jsonMessage := graphqlResult[name]
+		res := results[name]
 		if name == "viewer" {
-			viewerResult := struct {
+			var viewerResult struct {
 				Login string
-			}{}
-			decoder := json.NewDecoder(bytes.NewReader([]byte(*jsonMessage)))
-			if err := decoder.Decode(&viewerResult); err != nil {
+			}
+			if err := res.Decode(&viewerResult); err != nil {
 				return result, err
 			}
 			result.ViewerLogin = viewerResult.Login
 		} else if strings.HasPrefix(name, "repo_") {
-			if jsonMessage == nil {
+			if res.Value == nil {
 				result.Repositories = append(result.Repositories, nil)
 				continue
 			}
 			var repo Repository
-			decoder := json.NewDecoder(bytes.NewReader(*jsonMessage))
-			if err := decoder.Decode(&repo); err != nil {
+			if err := res.Decode(&repo); err != nil {
 				return result, err
 			}
 			result.Repositories = append(result.Repositories, &repo)
@@ -189,11 +268,28 @@ func RepoNetwork(client *Client, repos []ghrepo.Interface) (RepoNetworkResult, e
 
 // repositoryV3 is the repository result from GitHub API v3
 type repositoryV3 struct {
-	NodeID    string
-	Name      string
-	CreatedAt time.Time `json:"created_at"`
-	CloneURL  string    `json:"clone_url"`
-	Owner     struct {
+	NodeID          string
+	Name            string
+	CreatedAt       time.Time `json:"created_at"`
+	PushedAt        time.Time `json:"pushed_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	CloneURL        string    `json:"clone_url"`
+	SSHURL          string    `json:"ssh_url"`
+	MirrorURL       string    `json:"mirror_url"`
+	Description     string    `json:"description"`
+	Homepage        string    `json:"homepage"`
+	Language        string    `json:"language"`
+	Topics          []string  `json:"topics"`
+	StargazerCount  int       `json:"stargazers_count"`
+	ForkCount       int       `json:"forks_count"`
+	OpenIssuesCount int       `json:"open_issues_count"`
+	Archived        bool      `json:"archived"`
+	IsTemplate      bool      `json:"is_template"`
+	License         *struct {
+		Key  string `json:"key"`
+		Name string `json:"name"`
+	} `json:"license"`
+	Owner struct {
 		Login string
 	}
 }
@@ -208,16 +304,38 @@ func ForkRepo(client *Client, repo ghrepo.Interface) (*Repository, error) {
 		return nil, err
 	}
 
-	return &Repository{
-		ID:        result.NodeID,
-		Name:      result.Name,
-		CloneURL:  result.CloneURL,
-		CreatedAt: result.CreatedAt,
+	newRepo := &Repository{
+		ID:              result.NodeID,
+		Name:            result.Name,
+		CloneURL:        result.CloneURL,
+		SSHURL:          result.SSHURL,
+		MirrorURL:       result.MirrorURL,
+		CreatedAt:       result.CreatedAt,
+		PushedAt:        result.PushedAt,
+		UpdatedAt:       result.UpdatedAt,
+		Description:     result.Description,
+		Homepage:        result.Homepage,
+		Language:        result.Language,
+		Topics:          result.Topics,
+		StargazerCount:  result.StargazerCount,
+		ForkCount:       result.ForkCount,
+		OpenIssuesCount: result.OpenIssuesCount,
+		IsArchived:      result.Archived,
+		IsTemplate:      result.IsTemplate,
 		Owner: RepositoryOwner{
 			Login: result.Owner.Login,
 		},
 		ViewerPermission: "WRITE",
-	}, nil
+	}
+	if result.License != nil {
+		newRepo.LicenseInfo = &RepositoryLicense{
+			Key:  result.License.Key,
+			Name: result.License.Name,
+		}
+	}
+	newRepo.syncLanguage()
+
+	return newRepo, nil
 }
 
 // RepoCreateInput represents input parameters for RepoCreate
@@ -227,11 +345,25 @@ type RepoCreateInput struct {
 	Homepage    string `json:"homepage,omitempty"`
 	Description string `json:"description,omitempty"`
 
+	// OwnerID's meaning is forge-specific, since ForgeClient implementations
+	// each resolve it against a different identifier space: for GitHub it's
+	// an org login that RepoCreate resolves to a GraphQL node ID via
+	// resolveOrganization/resolveOrganizationTeam; for GitLab and Gitea it's
+	// unused (both create repos under the authenticated user); for Bitbucket
+	// Server's CreateRepo it's used verbatim as the target project's literal
+	// key in the REST path. Callers building one input across forges must
+	// pass whichever of these the target forge expects.
 	OwnerID string `json:"ownerId,omitempty"`
 	TeamID  string `json:"teamId,omitempty"`
 
 	HasIssuesEnabled bool `json:"hasIssuesEnabled"`
 	HasWikiEnabled   bool `json:"hasWikiEnabled"`
+
+	// IsTemplate marks the new repository as usable as a template for others.
+	IsTemplate bool `json:"isTemplate,omitempty"`
+	// Topics are applied to the repository once it's been created, since
+	// CreateRepositoryInput has no field for them.
+	Topics []string `json:"-"`
 }
 
 // RepoCreate creates a new GitHub repository
@@ -277,5 +409,31 @@ func RepoCreate(client *Client, input RepoCreateInput) (*Repository, error) {
 		return nil, err
 	}
 
-	return &response.CreateRepository.Repository, nil
+	newRepo := &response.CreateRepository.Repository
+	if len(input.Topics) > 0 {
+		if err := updateRepoTopics(client, newRepo.ID, input.Topics); err != nil {
+			return newRepo, err
+		}
+		newRepo.Topics = input.Topics
+	}
+
+	return newRepo, nil
+}
+
+// updateRepoTopics sets the topics of a newly created repository, since
+// CreateRepositoryInput has no field for them.
+func updateRepoTopics(client *Client, repoID string, topics []string) error {
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"repositoryId": repoID,
+			"topicNames":   topics,
+		},
+	}
+	return client.GraphQL(`
+	mutation($input: UpdateTopicsInput!) {
+		updateTopics(input: $input) {
+			invalidTopicNames
+		}
+	}
+	`, variables, &struct{}{})
 }
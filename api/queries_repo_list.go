@@ -0,0 +1,314 @@
+package api
+
+// graphQLClient is the subset of *Client that RepoList (and its
+// cursor-expansion helpers) need, extracted so tests can exercise
+// pagination and fork/collaborator expansion without a real HTTP round
+// trip. *Client already satisfies this.
+type graphQLClient interface {
+	GraphQL(query string, variables map[string]interface{}, data interface{}) error
+}
+
+// RepoListOptions filters the repositories returned by RepoList.
+type RepoListOptions struct {
+	// Visibility is "public", "private", or "" to include both.
+	Visibility string
+	// Affiliation is one of OWNER, COLLABORATOR, ORGANIZATION_MEMBER, or "" for any.
+	Affiliation string
+	IsFork      *bool
+	IsArchived  *bool
+	Language    string
+	Topic       string
+
+	// PageSize is the number of repositories requested per GraphQL round
+	// trip. It defaults to 50 when left at zero.
+	PageSize int
+}
+
+// RepoListCallback is invoked once per page of results streamed by RepoList.
+// Returning an error aborts pagination.
+type RepoListCallback func(page []*Repository) error
+
+// repoListFragment mirrors the repository fields requested by RepoList,
+// including a single page of each repository's fork and collaborator
+// connections. Repos with more forks or collaborators than fit in that
+// first page get expanded with a follow-up query in fetchRemainingForks /
+// fetchRemainingCollaborators rather than restarting the whole listing.
+type repoListFragment struct {
+	Repository
+	RepositoryTopics struct {
+		Nodes []struct {
+			Topic struct {
+				Name string
+			}
+		}
+	}
+	Forks struct {
+		TotalCount int
+		PageInfo   pageInfo
+		Nodes      []Repository
+	}
+	Collaborators struct {
+		TotalCount int
+		PageInfo   pageInfo
+		Nodes      []struct {
+			Login string
+		}
+	}
+}
+
+func (n repoListFragment) export(client graphQLClient) (*Repository, error) {
+	repo := n.Repository
+	for _, node := range n.RepositoryTopics.Nodes {
+		repo.Topics = append(repo.Topics, node.Topic.Name)
+	}
+	repo.syncLanguage()
+
+	repo.ForkCount = n.Forks.TotalCount
+	repo.Forks = n.Forks.Nodes
+	if n.Forks.PageInfo.HasNextPage {
+		rest, err := fetchRemainingForks(client, repo.ID, n.Forks.PageInfo.EndCursor)
+		if err != nil {
+			return nil, err
+		}
+		repo.Forks = append(repo.Forks, rest...)
+	}
+
+	for _, node := range n.Collaborators.Nodes {
+		repo.Collaborators = append(repo.Collaborators, node.Login)
+	}
+	if n.Collaborators.PageInfo.HasNextPage {
+		rest, err := fetchRemainingCollaborators(client, repo.ID, n.Collaborators.PageInfo.EndCursor)
+		if err != nil {
+			return nil, err
+		}
+		repo.Collaborators = append(repo.Collaborators, rest...)
+	}
+
+	return &repo, nil
+}
+
+type pageInfo struct {
+	HasNextPage bool
+	EndCursor   string
+}
+
+// RepoList lists an owner's repositories using GraphQL cursor-based
+// pagination, invoking onPage once per page so that callers like `gh repo
+// list` can stream through thousands of repositories without holding them
+// all in memory at once. Each repository's parent, forks, and
+// collaborators are expanded alongside it: parent is small enough to fetch
+// inline, while forks and collaborators fall back to a follow-up
+// `node(id:)` query (fetchRemainingForks / fetchRemainingCollaborators)
+// when either connection has more pages than fit in this query's first page.
+func RepoList(client graphQLClient, owner string, opts RepoListOptions, onPage RepoListCallback) error {
+	perPage := opts.PageSize
+	if perPage <= 0 {
+		perPage = 50
+	}
+
+	query := `
+	query($owner: String!, $perPage: Int!, $endCursor: String, $isFork: Boolean, $isArchived: Boolean, $affiliations: [RepositoryAffiliation!], $privacy: RepositoryPrivacy) {
+		repositoryOwner(login: $owner) {
+			repositories(first: $perPage, after: $endCursor, isFork: $isFork, isArchived: $isArchived, ownerAffiliations: $affiliations, privacy: $privacy) {
+				pageInfo { hasNextPage endCursor }
+				nodes {
+					...repoListFields
+					parent {
+						...repoListFields
+					}
+					forks(first: 10) {
+						totalCount
+						pageInfo { hasNextPage endCursor }
+						nodes { ...repoListFields }
+					}
+					collaborators(first: 10) {
+						totalCount
+						pageInfo { hasNextPage endCursor }
+						nodes { login }
+					}
+				}
+			}
+		}
+	}
+	fragment repoListFields on Repository {
+		id
+		name
+		url
+		isPrivate
+		isFork
+		isArchived
+		primaryLanguage { name }
+		repositoryTopics(first: 10) { nodes { topic { name } } }
+		owner { login }
+	}`
+
+	variables := map[string]interface{}{
+		"owner":        owner,
+		"perPage":      perPage,
+		"endCursor":    (*string)(nil),
+		"isFork":       opts.IsFork,
+		"isArchived":   opts.IsArchived,
+		"affiliations": affiliationFilter(opts.Affiliation),
+		"privacy":      visibilityFilter(opts.Visibility),
+	}
+
+	for {
+		var result struct {
+			RepositoryOwner struct {
+				Repositories struct {
+					PageInfo pageInfo
+					Nodes    []repoListFragment
+				}
+			}
+		}
+		if err := client.GraphQL(query, variables, &result); err != nil {
+			return err
+		}
+
+		repos := result.RepositoryOwner.Repositories
+		page := make([]*Repository, 0, len(repos.Nodes))
+		for _, node := range repos.Nodes {
+			if opts.Language != "" && (node.PrimaryLanguage == nil || node.PrimaryLanguage.Name != opts.Language) {
+				continue
+			}
+			repo, err := node.export(client)
+			if err != nil {
+				return err
+			}
+			if opts.Topic != "" && !hasTopic(*repo, opts.Topic) {
+				continue
+			}
+			page = append(page, repo)
+		}
+
+		if err := onPage(page); err != nil {
+			return err
+		}
+
+		if !repos.PageInfo.HasNextPage {
+			return nil
+		}
+		variables["endCursor"] = repos.PageInfo.EndCursor
+	}
+}
+
+func hasTopic(repo Repository, topic string) bool {
+	for _, t := range repo.Topics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+func affiliationFilter(affiliation string) []string {
+	if affiliation == "" {
+		return []string{"OWNER", "COLLABORATOR", "ORGANIZATION_MEMBER"}
+	}
+	return []string{affiliation}
+}
+
+func visibilityFilter(visibility string) interface{} {
+	switch visibility {
+	case "public":
+		return "PUBLIC"
+	case "private":
+		return "PRIVATE"
+	default:
+		return nil
+	}
+}
+
+// fetchRemainingForks follows up on a single repository's fork connection
+// when it has more pages than fit in the outer RepoList query, walking its
+// cursor directly via the `node(id:)` query rather than re-running the
+// whole owner listing.
+func fetchRemainingForks(client graphQLClient, repoID, afterCursor string) ([]Repository, error) {
+	query := `
+	query($id: ID!, $endCursor: String) {
+		node(id: $id) {
+			... on Repository {
+				forks(first: 50, after: $endCursor) {
+					pageInfo { hasNextPage endCursor }
+					nodes {
+						id
+						name
+						url
+						isPrivate
+						isFork
+						owner { login }
+					}
+				}
+			}
+		}
+	}`
+
+	var forks []Repository
+	variables := map[string]interface{}{
+		"id":        repoID,
+		"endCursor": afterCursor,
+	}
+	for {
+		var result struct {
+			Node struct {
+				Forks struct {
+					PageInfo pageInfo
+					Nodes    []Repository
+				}
+			}
+		}
+		if err := client.GraphQL(query, variables, &result); err != nil {
+			return nil, err
+		}
+		forks = append(forks, result.Node.Forks.Nodes...)
+		if !result.Node.Forks.PageInfo.HasNextPage {
+			return forks, nil
+		}
+		variables["endCursor"] = result.Node.Forks.PageInfo.EndCursor
+	}
+}
+
+// fetchRemainingCollaborators follows up on a single repository's
+// collaborator connection when it has more pages than fit in the outer
+// RepoList query, the same way fetchRemainingForks does for forks.
+func fetchRemainingCollaborators(client graphQLClient, repoID, afterCursor string) ([]string, error) {
+	query := `
+	query($id: ID!, $endCursor: String) {
+		node(id: $id) {
+			... on Repository {
+				collaborators(first: 50, after: $endCursor) {
+					pageInfo { hasNextPage endCursor }
+					nodes { login }
+				}
+			}
+		}
+	}`
+
+	var logins []string
+	variables := map[string]interface{}{
+		"id":        repoID,
+		"endCursor": afterCursor,
+	}
+	for {
+		var result struct {
+			Node struct {
+				Collaborators struct {
+					PageInfo pageInfo
+					Nodes    []struct {
+						Login string
+					}
+				}
+			}
+		}
+		if err := client.GraphQL(query, variables, &result); err != nil {
+			return nil, err
+		}
+		for _, node := range result.Node.Collaborators.Nodes {
+			logins = append(logins, node.Login)
+		}
+		if !result.Node.Collaborators.PageInfo.HasNextPage {
+			return logins, nil
+		}
+		variables["endCursor"] = result.Node.Collaborators.PageInfo.EndCursor
+	}
+}
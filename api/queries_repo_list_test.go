@@ -0,0 +1,137 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// fakeGraphQLClient replays a fixed sequence of responses, one per call, so
+// tests can drive RepoList's pagination and cursor-expansion paths without a
+// real HTTP round trip.
+type fakeGraphQLClient struct {
+	t         *testing.T
+	responses []interface{}
+	calls     int
+}
+
+func (f *fakeGraphQLClient) GraphQL(query string, variables map[string]interface{}, data interface{}) error {
+	if f.calls >= len(f.responses) {
+		f.t.Fatalf("unexpected GraphQL call #%d (query=%s vars=%v)", f.calls, query, variables)
+	}
+	resp := f.responses[f.calls]
+	f.calls++
+	b, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, data)
+}
+
+func TestRepoList_pagination(t *testing.T) {
+	client := &fakeGraphQLClient{t: t, responses: []interface{}{
+		map[string]interface{}{
+			"repositoryOwner": map[string]interface{}{
+				"repositories": map[string]interface{}{
+					"pageInfo": map[string]interface{}{"hasNextPage": true, "endCursor": "page2"},
+					"nodes": []interface{}{
+						map[string]interface{}{
+							"id": "repo-a", "name": "a", "owner": map[string]interface{}{"login": "cli"},
+						},
+					},
+				},
+			},
+		},
+		map[string]interface{}{
+			"repositoryOwner": map[string]interface{}{
+				"repositories": map[string]interface{}{
+					"pageInfo": map[string]interface{}{"hasNextPage": false},
+					"nodes": []interface{}{
+						map[string]interface{}{
+							"id": "repo-b", "name": "b", "owner": map[string]interface{}{"login": "cli"},
+						},
+					},
+				},
+			},
+		},
+	}}
+
+	var pages [][]*Repository
+	err := RepoList(client, "cli", RepoListOptions{}, func(page []*Repository) error {
+		pages = append(pages, page)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("RepoList returned error: %v", err)
+	}
+
+	if len(pages) != 2 {
+		t.Fatalf("expected 2 pages, got %d", len(pages))
+	}
+	if len(pages[0]) != 1 || pages[0][0].Name != "a" {
+		t.Errorf("expected page 1 to contain repo %q, got %+v", "a", pages[0])
+	}
+	if len(pages[1]) != 1 || pages[1][0].Name != "b" {
+		t.Errorf("expected page 2 to contain repo %q, got %+v", "b", pages[1])
+	}
+	if client.calls != 2 {
+		t.Errorf("expected 2 GraphQL calls, got %d", client.calls)
+	}
+}
+
+func TestRepoList_forkExpansion(t *testing.T) {
+	client := &fakeGraphQLClient{t: t, responses: []interface{}{
+		map[string]interface{}{
+			"repositoryOwner": map[string]interface{}{
+				"repositories": map[string]interface{}{
+					"pageInfo": map[string]interface{}{"hasNextPage": false},
+					"nodes": []interface{}{
+						map[string]interface{}{
+							"id": "repo-a", "name": "a", "owner": map[string]interface{}{"login": "cli"},
+							"forks": map[string]interface{}{
+								"totalCount": 2,
+								"pageInfo":   map[string]interface{}{"hasNextPage": true, "endCursor": "fork-cursor"},
+								"nodes": []interface{}{
+									map[string]interface{}{"id": "fork-1", "name": "a-fork-1"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		// fetchRemainingForks follow-up query
+		map[string]interface{}{
+			"node": map[string]interface{}{
+				"forks": map[string]interface{}{
+					"pageInfo": map[string]interface{}{"hasNextPage": false},
+					"nodes": []interface{}{
+						map[string]interface{}{"id": "fork-2", "name": "a-fork-2"},
+					},
+				},
+			},
+		},
+	}}
+
+	var page []*Repository
+	err := RepoList(client, "cli", RepoListOptions{}, func(p []*Repository) error {
+		page = p
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("RepoList returned error: %v", err)
+	}
+
+	if len(page) != 1 {
+		t.Fatalf("expected 1 repo, got %d", len(page))
+	}
+	repo := page[0]
+	if repo.ForkCount != 2 {
+		t.Errorf("expected ForkCount 2, got %d", repo.ForkCount)
+	}
+	if len(repo.Forks) != 2 || repo.Forks[0].Name != "a-fork-1" || repo.Forks[1].Name != "a-fork-2" {
+		t.Errorf("expected both fork pages merged into Forks, got %+v", repo.Forks)
+	}
+	if client.calls != 2 {
+		t.Errorf("expected 2 GraphQL calls (list + fork expansion), got %d", client.calls)
+	}
+}
@@ -0,0 +1,170 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// SearchQuery is a typed builder for GitHub's repository search syntax. Zero
+// values are omitted, so callers only need to set the fields they care
+// about before handing the query to SearchRepos.
+type SearchQuery struct {
+	Keywords []string
+
+	Owner    string
+	Language string
+	Topic    string
+	License  string
+	Size     string // e.g. ">1000", "10..100", in KB as GitHub's `size:` qualifier expects
+	Archived *bool
+
+	MinStars int
+	MaxStars int
+
+	PushedRange  string // e.g. ">2021-01-01"
+	CreatedRange string
+
+	// Qualifiers holds any additional `key:value` search qualifiers not
+	// covered by a dedicated field above.
+	Qualifiers map[string]string
+
+	Sort  string // stars, forks, updated, or "" for best-match
+	Order string // asc or desc
+}
+
+// String compiles the query into GitHub's search qualifier syntax, e.g.
+// `cli language:go archived:false stars:>=100`.
+func (q SearchQuery) String() string {
+	var parts []string
+	parts = append(parts, q.Keywords...)
+
+	if q.Owner != "" {
+		parts = append(parts, fmt.Sprintf("org:%s", q.Owner))
+	}
+	if q.Language != "" {
+		parts = append(parts, fmt.Sprintf("language:%s", q.Language))
+	}
+	if q.Topic != "" {
+		parts = append(parts, fmt.Sprintf("topic:%s", q.Topic))
+	}
+	if q.License != "" {
+		parts = append(parts, fmt.Sprintf("license:%s", q.License))
+	}
+	if q.Size != "" {
+		parts = append(parts, fmt.Sprintf("size:%s", q.Size))
+	}
+	if q.Archived != nil {
+		parts = append(parts, fmt.Sprintf("archived:%t", *q.Archived))
+	}
+	if q.MinStars > 0 && q.MaxStars > 0 {
+		parts = append(parts, fmt.Sprintf("stars:%d..%d", q.MinStars, q.MaxStars))
+	} else if q.MinStars > 0 {
+		parts = append(parts, fmt.Sprintf("stars:>=%d", q.MinStars))
+	} else if q.MaxStars > 0 {
+		parts = append(parts, fmt.Sprintf("stars:<=%d", q.MaxStars))
+	}
+	if q.PushedRange != "" {
+		parts = append(parts, fmt.Sprintf("pushed:%s", q.PushedRange))
+	}
+	if q.CreatedRange != "" {
+		parts = append(parts, fmt.Sprintf("created:%s", q.CreatedRange))
+	}
+	for key, value := range q.Qualifiers {
+		parts = append(parts, fmt.Sprintf("%s:%s", key, value))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// SearchReposOptions configures a single page of SearchRepos.
+type SearchReposOptions struct {
+	Query SearchQuery
+	Limit int
+	Page  int
+}
+
+// SearchReposResult is a page of repository search results.
+type SearchReposResult struct {
+	TotalCount        int
+	IncompleteResults bool
+	Repositories      []Repository
+}
+
+type searchRepositoriesV3 struct {
+	TotalCount        int            `json:"total_count"`
+	IncompleteResults bool           `json:"incomplete_results"`
+	Items             []repositoryV3 `json:"items"`
+}
+
+// SearchRepos finds repositories across all of GitHub using the REST
+// `search/repositories` endpoint and SearchQuery's compiled qualifier
+// syntax. Results are paginated the same way as any other REST listing:
+// opts.Page selects the page and opts.Limit caps the page size (GitHub
+// allows up to 100 per page).
+func SearchRepos(client *Client, opts SearchReposOptions) (*SearchReposResult, error) {
+	limit := opts.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 30
+	}
+	page := opts.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	params := url.Values{}
+	params.Set("q", opts.Query.String())
+	params.Set("per_page", strconv.Itoa(limit))
+	params.Set("page", strconv.Itoa(page))
+	if opts.Query.Sort != "" {
+		params.Set("sort", opts.Query.Sort)
+	}
+	if opts.Query.Order != "" {
+		params.Set("order", opts.Query.Order)
+	}
+
+	path := fmt.Sprintf("search/repositories?%s", params.Encode())
+	var raw searchRepositoriesV3
+	if err := client.REST("GET", path, nil, &raw); err != nil {
+		return nil, err
+	}
+
+	result := &SearchReposResult{
+		TotalCount:        raw.TotalCount,
+		IncompleteResults: raw.IncompleteResults,
+		Repositories:      make([]Repository, 0, len(raw.Items)),
+	}
+	for _, item := range raw.Items {
+		result.Repositories = append(result.Repositories, repoFromV3(item))
+	}
+	return result, nil
+}
+
+func repoFromV3(v repositoryV3) Repository {
+	repo := Repository{
+		ID:              v.NodeID,
+		Name:            v.Name,
+		CloneURL:        v.CloneURL,
+		SSHURL:          v.SSHURL,
+		MirrorURL:       v.MirrorURL,
+		CreatedAt:       v.CreatedAt,
+		PushedAt:        v.PushedAt,
+		UpdatedAt:       v.UpdatedAt,
+		Description:     v.Description,
+		Homepage:        v.Homepage,
+		Language:        v.Language,
+		Topics:          v.Topics,
+		StargazerCount:  v.StargazerCount,
+		ForkCount:       v.ForkCount,
+		OpenIssuesCount: v.OpenIssuesCount,
+		IsArchived:      v.Archived,
+		IsTemplate:      v.IsTemplate,
+		Owner:           RepositoryOwner{Login: v.Owner.Login},
+	}
+	if v.License != nil {
+		repo.LicenseInfo = &RepositoryLicense{Key: v.License.Key, Name: v.License.Name}
+	}
+	repo.syncLanguage()
+	return repo
+}
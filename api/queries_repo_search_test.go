@@ -0,0 +1,45 @@
+package api
+
+import "testing"
+
+func TestSearchQueryString(t *testing.T) {
+	archived := false
+	query := SearchQuery{
+		Keywords: []string{"cli", "terminal"},
+		Owner:    "cli",
+		Language: "go",
+		Topic:    "cli",
+		License:  "mit",
+		Archived: &archived,
+		MinStars: 100,
+		MaxStars: 5000,
+		Qualifiers: map[string]string{
+			"in": "name",
+		},
+	}
+
+	want := "cli terminal org:cli language:go topic:cli license:mit archived:false stars:100..5000 in:name"
+	if got := query.String(); got != want {
+		t.Errorf("SearchQuery.String() = %q, want %q", got, want)
+	}
+}
+
+func TestSearchQueryString_starsOnlyMin(t *testing.T) {
+	query := SearchQuery{MinStars: 50}
+	if got, want := query.String(), "stars:>=50"; got != want {
+		t.Errorf("SearchQuery.String() = %q, want %q", got, want)
+	}
+}
+
+func TestSearchQueryString_starsOnlyMax(t *testing.T) {
+	query := SearchQuery{MaxStars: 50}
+	if got, want := query.String(), "stars:<=50"; got != want {
+		t.Errorf("SearchQuery.String() = %q, want %q", got, want)
+	}
+}
+
+func TestSearchQueryString_empty(t *testing.T) {
+	if got, want := (SearchQuery{}).String(), ""; got != want {
+		t.Errorf("SearchQuery.String() = %q, want %q", got, want)
+	}
+}
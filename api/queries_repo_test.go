@@ -0,0 +1,29 @@
+package api
+
+import "testing"
+
+func TestRepositorySyncLanguage(t *testing.T) {
+	t.Run("fills Language from PrimaryLanguage", func(t *testing.T) {
+		repo := Repository{PrimaryLanguage: &RepositoryLanguage{Name: "Go"}}
+		repo.syncLanguage()
+		if repo.Language != "Go" {
+			t.Errorf("expected Language to be filled in from PrimaryLanguage, got %q", repo.Language)
+		}
+	})
+
+	t.Run("fills PrimaryLanguage from Language", func(t *testing.T) {
+		repo := Repository{Language: "Go"}
+		repo.syncLanguage()
+		if repo.PrimaryLanguage == nil || repo.PrimaryLanguage.Name != "Go" {
+			t.Errorf("expected PrimaryLanguage to be filled in from Language, got %+v", repo.PrimaryLanguage)
+		}
+	})
+
+	t.Run("leaves both empty alone", func(t *testing.T) {
+		repo := Repository{}
+		repo.syncLanguage()
+		if repo.Language != "" || repo.PrimaryLanguage != nil {
+			t.Errorf("expected no-op when both are empty, got %+v", repo)
+		}
+	})
+}